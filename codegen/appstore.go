@@ -0,0 +1,80 @@
+// Package codegen contains the request/response types generated from the
+// CasaOS-AppManagement OpenAPI specification, plus the hand-maintained
+// additions layered on top as the spec grows.
+package codegen
+
+import "time"
+
+// AppStoreID identifies a registered app store by its position in the list
+// returned by AppStoreList.
+type AppStoreID = int
+
+// StoreAppIDString identifies a single app within a store's catalog.
+type StoreAppIDString = string
+
+type AppStoreMetadata struct {
+	URL *string `json:"url,omitempty"`
+
+	// Valid, Violations and LastModified reflect the result of the last
+	// validation pass - run on registration and on every refresh - over the
+	// store's compose apps. Violations is nil/empty when Valid is true.
+	Valid        *bool      `json:"valid,omitempty"`
+	Violations   *[]string  `json:"violations,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+
+	// LastSyncedAt and LastError reflect the background periodic sync loop -
+	// see AppStoreStatus for the full picture, including sync duration.
+	LastSyncedAt *time.Time `json:"lastSyncedAt,omitempty"`
+	LastError    *string    `json:"lastError,omitempty"`
+}
+
+// AppStoreStatus reports the health of an app store's background sync.
+type AppStoreStatus struct {
+	LastSyncedAt *time.Time `json:"lastSyncedAt,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	LastError    *string    `json:"lastError,omitempty"`
+
+	// SyncDurationSeconds is a rolling average over recent sync runs.
+	SyncDurationSeconds *float64 `json:"syncDurationSeconds,omitempty"`
+}
+
+type AppStoreStatusOK struct {
+	Data *AppStoreStatus `json:"data,omitempty"`
+}
+
+type AppStoreSyncOK struct {
+	Message *string `json:"message,omitempty"`
+}
+
+type RegisterAppStoreParams struct {
+	Url *string `json:"url,omitempty"`
+}
+
+type AppStoreListOK struct {
+	Data *[]AppStoreMetadata `json:"data,omitempty"`
+}
+
+type AppStoreRegisterOK struct {
+	Message *string           `json:"message,omitempty"`
+	Data    *AppStoreMetadata `json:"data,omitempty"`
+}
+
+type AppStoreViolationsOK struct {
+	Data *[]string `json:"data,omitempty"`
+}
+
+type AppStoreUnregisterOK struct {
+	Message *string `json:"message,omitempty"`
+}
+
+type ResponseBadRequest struct {
+	Message *string `json:"message,omitempty"`
+}
+
+type ResponseNotFound struct {
+	Message *string `json:"message,omitempty"`
+}
+
+type ResponseInternalServerError struct {
+	Message *string `json:"message,omitempty"`
+}
@@ -0,0 +1,16 @@
+package codegen
+
+type ComposeAppVersionListOK struct {
+	Data *[]string `json:"data,omitempty"`
+}
+
+type ComposeAppUpdate struct {
+	StoreAppID         *string `json:"storeAppId,omitempty"`
+	CurrentVersion     *string `json:"currentVersion,omitempty"`
+	LatestVersion      *string `json:"latestVersion,omitempty"`
+	CrossVersionUpdate *bool   `json:"crossVersionUpdate,omitempty"`
+}
+
+type InstalledAppUpdateListOK struct {
+	Data *[]ComposeAppUpdate `json:"data,omitempty"`
+}
@@ -0,0 +1,144 @@
+package codegen
+
+import "time"
+
+type StoreAppAuthorType string
+
+const (
+	Official  StoreAppAuthorType = "official"
+	ByCasaos  StoreAppAuthorType = "by_casaos"
+	Community StoreAppAuthorType = "community"
+)
+
+// ComposeApp mirrors a docker-compose document. Its fields are intentionally
+// loose since a compose file's shape is defined by the compose spec, not by
+// this API - the one CasaOS-specific addition is the `x-casaos` extension,
+// which carries everything the store/catalog layer needs to know about the
+// app (store id, category, tags, author type, version).
+type ComposeApp struct {
+	Name     string                    `json:"name,omitempty" yaml:"name,omitempty"`
+	Services map[string]ComposeService `json:"services,omitempty" yaml:"services,omitempty"`
+	XCasaOS  *XCasaOSExtension         `json:"x-casaos,omitempty" yaml:"x-casaos,omitempty"`
+
+	// AddedAt is when the store layer first indexed this app. It's stamped
+	// by the store, not read from the compose file, so it survives the app
+	// being edited in place and backs Catalog's "recent" sort order.
+	AddedAt *time.Time `json:"addedAt,omitempty" yaml:"-"`
+}
+
+// ComposeService is a single service (container) in a compose app, trimmed
+// down to the fields the store/catalog layer needs.
+type ComposeService struct {
+	Image string `yaml:"image,omitempty"`
+}
+
+// XCasaOSExtension is the `x-casaos` compose extension CasaOS app stores use
+// to attach store metadata to an otherwise plain docker-compose file.
+type XCasaOSExtension struct {
+	Title          string             `yaml:"title,omitempty"`
+	Icon           string             `yaml:"icon,omitempty"`
+	MainApp        string             `yaml:"main,omitempty"`
+	StoreAppID     string             `yaml:"store_app_id,omitempty"`
+	Category       string             `yaml:"category,omitempty"`
+	Tags           []string           `yaml:"tags,omitempty"`
+	Version        string             `yaml:"version,omitempty"`
+	Versions       []string           `yaml:"versions,omitempty"`
+	AuthorType     StoreAppAuthorType `yaml:"author_type,omitempty"`
+	RecommendOrder int                `yaml:"recommend_order,omitempty"`
+}
+
+type ComposeAppWithStoreInfo struct {
+	StoreInfo *ComposeAppStoreInfo `json:"storeInfo,omitempty"`
+	Compose   *ComposeApp          `json:"compose,omitempty"`
+}
+
+type ComposeAppOK struct {
+	Message *string                  `json:"message,omitempty"`
+	Data    *ComposeAppWithStoreInfo `json:"data,omitempty"`
+}
+
+type ComposeAppStoreInfo struct {
+	StoreAppID     *string   `json:"storeAppId,omitempty"`
+	Category       string    `json:"category,omitempty"`
+	Version        *string   `json:"version,omitempty"`
+	Tags           *[]string `json:"tags,omitempty"`
+	RecommendOrder *int      `json:"recommendOrder,omitempty"`
+}
+
+type ComposeAppStoreInfoOK struct {
+	Data *ComposeAppStoreInfo `json:"data,omitempty"`
+}
+
+// TagsMatchMode selects how ComposeAppStoreInfoListParams.Tags are combined:
+// "or" (default) keeps apps carrying any of the tags, "and" requires all.
+type TagsMatchMode string
+
+// SortMode selects the ordering ComposeAppStoreInfoList applies to its
+// result, on top of any category/tag/name filtering.
+type SortMode string
+
+const (
+	// SortName orders alphabetically by app name. It's the default when Sort
+	// is unset.
+	SortName SortMode = "name"
+
+	// SortRecent orders by AddedAt, newest first.
+	SortRecent SortMode = "recent"
+
+	// SortRecommended orders by recommendOrder, highest first.
+	SortRecommended SortMode = "recommended"
+)
+
+type ComposeAppStoreInfoListParams struct {
+	Category   *string             `json:"category,omitempty"`
+	AuthorType *StoreAppAuthorType `json:"authorType,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+	Tags       *[]string           `json:"tags,omitempty"`
+	TagsMode   *TagsMatchMode      `json:"tagsMode,omitempty"`
+
+	// IncludeTags/ExcludeTags apply alongside Tags/TagsMode, at the same
+	// store-level filtering step, before sort and pagination - not as a
+	// second pass over an already-fetched page.
+	IncludeTags *[]string `json:"includeTags,omitempty"`
+	ExcludeTags *[]string `json:"excludeTags,omitempty"`
+
+	Sort     *SortMode `json:"sort,omitempty"`
+	Page     *int      `json:"page,omitempty"`
+	PageSize *int      `json:"pageSize,omitempty"`
+}
+
+type ComposeAppStoreInfoLists struct {
+	List      *map[string]ComposeAppStoreInfo `json:"list,omitempty"`
+	Installed *[]string                       `json:"installed,omitempty"`
+	Total     *int                            `json:"total,omitempty"`
+	Page      *int                            `json:"page,omitempty"`
+	PageSize  *int                            `json:"pageSize,omitempty"`
+}
+
+type ComposeAppStoreInfoListsOK struct {
+	Message *string                   `json:"message,omitempty"`
+	Data    *ComposeAppStoreInfoLists `json:"data,omitempty"`
+}
+
+type CategoryInfo struct {
+	ID          *int    `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Font        *string `json:"font,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Count       *int    `json:"count,omitempty"`
+}
+
+type CategoryListOK struct {
+	Data *[]CategoryInfo `json:"data,omitempty"`
+}
+
+// Tag aggregates, across every registered store, an app tag and how many
+// apps carry it.
+type Tag struct {
+	Name  *string `json:"name,omitempty"`
+	Count *int    `json:"count,omitempty"`
+}
+
+type TagListOK struct {
+	Data *[]Tag `json:"data,omitempty"`
+}
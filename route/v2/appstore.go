@@ -45,7 +45,8 @@ func (a *AppManagement) RegisterAppStore(ctx echo.Context, params codegen.Regist
 
 	backgroundCtx := common.WithProperties(context.Background(), PropertiesFromQueryParams(ctx))
 
-	if err := service.MyService.AppStoreManagement().RegisterAppStore(backgroundCtx, *params.Url); err != nil {
+	metadata, err := service.MyService.AppStoreManagement().RegisterAppStore(backgroundCtx, *params.Url)
+	if err != nil {
 		message := err.Error()
 		if err == service.ErrNotAppStore {
 			return ctx.JSON(http.StatusBadRequest, codegen.ResponseBadRequest{Message: &message})
@@ -54,10 +55,76 @@ func (a *AppManagement) RegisterAppStore(ctx echo.Context, params codegen.Regist
 		return ctx.JSON(http.StatusInternalServerError, codegen.ResponseInternalServerError{Message: &message})
 	}
 
-	logFilepath := filepath.Join(config.AppInfo.LogPath, fmt.Sprintf("%s.%s", config.AppInfo.LogSaveName, config.AppInfo.LogFileExt))
-	message := fmt.Sprintf("trying to register app store asynchronously - see %s for any errors.", logFilepath)
+	message := "app store is registered."
+	if metadata.Valid != nil && !*metadata.Valid {
+		logFilepath := filepath.Join(config.AppInfo.LogPath, fmt.Sprintf("%s.%s", config.AppInfo.LogSaveName, config.AppInfo.LogFileExt))
+		message = fmt.Sprintf("app store is registered, but %d violation(s) were found - see %s or GET /app-stores/{id}/violations for details.", len(lo.FromPtr(metadata.Violations)), logFilepath)
+	}
+
 	return ctx.JSON(http.StatusOK, codegen.AppStoreRegisterOK{
 		Message: &message,
+		Data:    &metadata,
+	})
+}
+
+func (a *AppManagement) AppStoreViolations(ctx echo.Context, id codegen.AppStoreID) error {
+	appStoreList := service.MyService.AppStoreManagement().AppStoreList()
+
+	if id < 0 || id >= len(appStoreList) {
+		message := fmt.Sprintf("app store id %d is not found", id)
+		return ctx.JSON(http.StatusNotFound, codegen.ResponseNotFound{Message: &message})
+	}
+
+	violations, err := service.MyService.AppStoreManagement().Violations(uint(id))
+	if err != nil {
+		message := err.Error()
+		logger.Error("failed to get app store violations", zap.Error(err), zap.Int("id", int(id)))
+		return ctx.JSON(http.StatusInternalServerError, codegen.ResponseInternalServerError{Message: &message})
+	}
+
+	return ctx.JSON(http.StatusOK, codegen.AppStoreViolationsOK{
+		Data: &violations,
+	})
+}
+
+func (a *AppManagement) AppStoreStatus(ctx echo.Context, id codegen.AppStoreID) error {
+	appStoreList := service.MyService.AppStoreManagement().AppStoreList()
+
+	if id < 0 || id >= len(appStoreList) {
+		message := fmt.Sprintf("app store id %d is not found", id)
+		return ctx.JSON(http.StatusNotFound, codegen.ResponseNotFound{Message: &message})
+	}
+
+	status, err := service.MyService.AppStoreManagement().Status(uint(id))
+	if err != nil {
+		message := err.Error()
+		logger.Error("failed to get app store status", zap.Error(err), zap.Int("id", int(id)))
+		return ctx.JSON(http.StatusInternalServerError, codegen.ResponseInternalServerError{Message: &message})
+	}
+
+	return ctx.JSON(http.StatusOK, codegen.AppStoreStatusOK{
+		Data: &status,
+	})
+}
+
+func (a *AppManagement) AppStoreSync(ctx echo.Context, id codegen.AppStoreID) error {
+	appStoreList := service.MyService.AppStoreManagement().AppStoreList()
+
+	if id < 0 || id >= len(appStoreList) {
+		message := fmt.Sprintf("app store id %d is not found", id)
+		return ctx.JSON(http.StatusNotFound, codegen.ResponseNotFound{Message: &message})
+	}
+
+	backgroundCtx := common.WithProperties(context.Background(), PropertiesFromQueryParams(ctx))
+
+	go func() {
+		if err := service.MyService.AppStoreManagement().Sync(backgroundCtx, uint(id)); err != nil {
+			logger.Error("app store sync failed", zap.Error(err), zap.Int("id", int(id)))
+		}
+	}()
+
+	return ctx.JSON(http.StatusOK, codegen.AppStoreSyncOK{
+		Message: utils.Ptr("app store sync triggered asynchronously - see GET /app-stores/{id}/status for progress."),
 	})
 }
 
@@ -85,32 +152,66 @@ func (a *AppManagement) UnregisterAppStore(ctx echo.Context, id codegen.AppStore
 }
 
 func (a *AppManagement) ComposeAppStoreInfoList(ctx echo.Context, params codegen.ComposeAppStoreInfoListParams) error {
-	catalog, err := service.MyService.V2AppStore().Catalog()
-	if err != nil {
-		message := err.Error()
-		logger.Error("failed to get catalog", zap.Error(err))
-		return ctx.JSON(http.StatusInternalServerError, codegen.ResponseInternalServerError{Message: &message})
+	filter := service.CatalogFilter{}
+
+	if params.Name != nil {
+		filter.Name = *params.Name
+	}
+
+	if params.Tags != nil {
+		filter.Tags = *params.Tags
+		filter.TagsMatchAll = params.TagsMode != nil && strings.EqualFold(string(*params.TagsMode), "and")
 	}
 
 	if params.Category != nil {
-		catalog = FilterCatalogByCategory(catalog, *params.Category)
+		filter.Category = *params.Category
 	}
 
 	if params.AuthorType != nil {
-		authorType := strings.ToLower(string(*params.AuthorType))
-		catalog = FilterCatalogByAuthorType(catalog, codegen.StoreAppAuthorType(authorType))
+		authorType := codegen.StoreAppAuthorType(strings.ToLower(string(*params.AuthorType)))
+		if !lo.Contains([]codegen.StoreAppAuthorType{codegen.Official, codegen.ByCasaos, codegen.Community}, authorType) {
+			logger.Info("warning: unknown author type - returning empty catalog", zap.String("authorType", string(authorType)))
+		}
+		filter.AuthorType = authorType
 	}
 
-	if params.Recommend != nil && *params.Recommend {
-		// recommend
-		recommendedList, err := service.MyService.V2AppStore().Recommend()
-		if err != nil {
-			message := err.Error()
-			logger.Error("failed to get recommend list", zap.Error(err))
-			return ctx.JSON(http.StatusInternalServerError, codegen.ResponseInternalServerError{Message: &message})
+	if params.IncludeTags != nil {
+		filter.IncludeTags = *params.IncludeTags
+	}
+
+	if params.ExcludeTags != nil {
+		filter.ExcludeTags = *params.ExcludeTags
+	}
+
+	page := 1
+	if params.Page != nil && *params.Page > 0 {
+		page = *params.Page
+	}
+
+	pageSize := 0
+	if params.PageSize != nil && *params.PageSize > 0 {
+		pageSize = *params.PageSize
+	}
+
+	filter.Page = page
+	filter.PageSize = pageSize
+
+	if params.Sort != nil {
+		switch strings.ToLower(string(*params.Sort)) {
+		case "name":
+			filter.Sort = service.CatalogSortName
+		case "recent":
+			filter.Sort = service.CatalogSortRecent
+		case "recommended":
+			filter.Sort = service.CatalogSortRecommended
 		}
+	}
 
-		catalog = FilterCatalogByAppStoreID(catalog, recommendedList)
+	catalog, total, err := service.MyService.V2AppStore().Catalog(filter)
+	if err != nil {
+		message := err.Error()
+		logger.Error("failed to get catalog", zap.Error(err))
+		return ctx.JSON(http.StatusInternalServerError, codegen.ResponseInternalServerError{Message: &message})
 	}
 
 	// list
@@ -125,7 +226,10 @@ func (a *AppManagement) ComposeAppStoreInfoList(ctx echo.Context, params codegen
 	})
 
 	data := &codegen.ComposeAppStoreInfoLists{
-		List: &list,
+		List:     &list,
+		Total:    &total,
+		Page:     &page,
+		PageSize: &pageSize,
 	}
 
 	// installed
@@ -183,6 +287,85 @@ func (a *AppManagement) ComposeAppStoreInfo(ctx echo.Context, id codegen.StoreAp
 	})
 }
 
+func (a *AppManagement) ComposeAppVersionList(ctx echo.Context, id codegen.StoreAppIDString) error {
+	composeApp, err := service.MyService.V2AppStore().ComposeApp(id)
+	if err != nil {
+		message := err.Error()
+		return ctx.JSON(http.StatusInternalServerError, codegen.ResponseInternalServerError{Message: &message})
+	}
+
+	if composeApp == nil {
+		return ctx.JSON(http.StatusNotFound, codegen.ResponseNotFound{
+			Message: utils.Ptr("app not found"),
+		})
+	}
+
+	versions, err := composeApp.Versions()
+	if err != nil {
+		message := err.Error()
+		logger.Error("failed to get app versions", zap.Error(err), zap.String("id", string(id)))
+		return ctx.JSON(http.StatusInternalServerError, codegen.ResponseInternalServerError{Message: &message})
+	}
+
+	return ctx.JSON(http.StatusOK, codegen.ComposeAppVersionListOK{
+		Data: &versions,
+	})
+}
+
+func (a *AppManagement) InstalledAppUpdateList(ctx echo.Context) error {
+	stamps := lastModifiedStamps(service.MyService.AppStoreManagement().AppStoreList())
+
+	if cached, ok := updateListCache.get(stamps); ok {
+		return ctx.JSON(http.StatusOK, codegen.InstalledAppUpdateListOK{
+			Data: &cached,
+		})
+	}
+
+	installedComposeApps, err := service.MyService.Compose().List(ctx.Request().Context())
+	if err != nil {
+		message := err.Error()
+		logger.Error("failed to list installed compose apps", zap.Error(err))
+		return ctx.JSON(http.StatusInternalServerError, codegen.ResponseInternalServerError{Message: &message})
+	}
+
+	updates := lo.FilterMap(lo.Values(installedComposeApps), func(composeApp *service.ComposeApp, i int) (codegen.ComposeAppUpdate, bool) {
+		storeInfo, err := composeApp.StoreInfo(false)
+		if err != nil || storeInfo == nil || storeInfo.StoreAppID == nil {
+			return codegen.ComposeAppUpdate{}, false
+		}
+
+		storeApp, err := service.MyService.V2AppStore().ComposeApp(*storeInfo.StoreAppID)
+		if err != nil || storeApp == nil {
+			return codegen.ComposeAppUpdate{}, false
+		}
+
+		latest, err := storeApp.LatestVersion()
+		if err != nil || latest == "" {
+			return codegen.ComposeAppUpdate{}, false
+		}
+
+		currentVersion := lo.FromPtr(storeInfo.Version)
+		if service.CompareVersions(currentVersion, latest) == 0 {
+			return codegen.ComposeAppUpdate{}, false
+		}
+
+		crossVersionUpdate := service.IsCrossMajorVersionUpdate(currentVersion, latest)
+
+		return codegen.ComposeAppUpdate{
+			StoreAppID:         storeInfo.StoreAppID,
+			CurrentVersion:     &currentVersion,
+			LatestVersion:      &latest,
+			CrossVersionUpdate: &crossVersionUpdate,
+		}, true
+	})
+
+	updateListCache.set(stamps, updates)
+
+	return ctx.JSON(http.StatusOK, codegen.InstalledAppUpdateListOK{
+		Data: &updates,
+	})
+}
+
 func (a *AppManagement) ComposeApp(ctx echo.Context, id codegen.StoreAppIDString) error {
 	composeApp, err := service.MyService.V2AppStore().ComposeApp(id)
 	if err != nil {
@@ -267,38 +450,19 @@ func (a *AppManagement) CategoryList(ctx echo.Context) error {
 	})
 }
 
-func FilterCatalogByCategory(catalog map[string]*service.ComposeApp, category string) map[string]*service.ComposeApp {
-	if category == "" {
-		return catalog
+func (a *AppManagement) TagList(ctx echo.Context) error {
+	tagMap, err := service.MyService.AppStoreManagement().TagMap()
+	if err != nil {
+		message := err.Error()
+		return ctx.JSON(http.StatusInternalServerError, codegen.ResponseInternalServerError{Message: &message})
 	}
 
-	return lo.PickBy(catalog, func(storeAppID string, composeApp *service.ComposeApp) bool {
-		storeInfo, err := composeApp.StoreInfo(true)
-		if err != nil {
-			return false
-		}
-
-		return strings.ToLower(storeInfo.Category) == strings.ToLower(category)
-	})
-}
+	tagList := lo.Values(tagMap)
 
-func FilterCatalogByAuthorType(catalog map[string]*service.ComposeApp, authorType codegen.StoreAppAuthorType) map[string]*service.ComposeApp {
-	if !lo.Contains([]codegen.StoreAppAuthorType{
-		codegen.Official,
-		codegen.ByCasaos,
-		codegen.Community,
-	}, authorType) {
-		logger.Info("warning: unknown author type - returning empty catalog", zap.String("authorType", string(authorType)))
-		return map[string]*service.ComposeApp{}
-	}
+	sort.Slice(tagList, func(i, j int) bool { return strings.Compare(*tagList[i].Name, *tagList[j].Name) < 0 })
 
-	return lo.PickBy(catalog, func(storeAppID string, composeApp *service.ComposeApp) bool {
-		return composeApp.AuthorType() == authorType
+	return ctx.JSON(http.StatusOK, codegen.TagListOK{
+		Data: &tagList,
 	})
 }
 
-func FilterCatalogByAppStoreID(catalog map[string]*service.ComposeApp, appStoreIDs []string) map[string]*service.ComposeApp {
-	return lo.PickBy(catalog, func(storeAppID string, composeApp *service.ComposeApp) bool {
-		return lo.Contains(appStoreIDs, storeAppID)
-	})
-}
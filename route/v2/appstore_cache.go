@@ -0,0 +1,65 @@
+package v2
+
+import (
+	"sync"
+	"time"
+
+	"github.com/IceWhaleTech/CasaOS-AppManagement/codegen"
+)
+
+// installedAppUpdateCache memoizes InstalledAppUpdateList's result against
+// the LastModified timestamp of every registered app store, so polling the
+// endpoint from the UI is cheap until a store's catalog actually changes.
+type installedAppUpdateCache struct {
+	mu      sync.Mutex
+	stamps  []time.Time
+	updates []codegen.ComposeAppUpdate
+}
+
+var updateListCache installedAppUpdateCache
+
+func (c *installedAppUpdateCache) get(stamps []time.Time) ([]codegen.ComposeAppUpdate, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !stampsEqual(c.stamps, stamps) {
+		return nil, false
+	}
+
+	return c.updates, true
+}
+
+func (c *installedAppUpdateCache) set(stamps []time.Time, updates []codegen.ComposeAppUpdate) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stamps = stamps
+	c.updates = updates
+}
+
+func stampsEqual(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lastModifiedStamps captures the LastModified of every registered app store,
+// in AppStoreList order, as the cache key for InstalledAppUpdateList.
+func lastModifiedStamps(appStoreList []codegen.AppStoreMetadata) []time.Time {
+	stamps := make([]time.Time, len(appStoreList))
+	for i, appStore := range appStoreList {
+		if appStore.LastModified != nil {
+			stamps[i] = *appStore.LastModified
+		}
+	}
+
+	return stamps
+}
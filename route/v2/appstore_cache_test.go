@@ -0,0 +1,32 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/IceWhaleTech/CasaOS-AppManagement/codegen"
+	"github.com/IceWhaleTech/CasaOS-Common/utils"
+)
+
+func TestInstalledAppUpdateCacheHitsUntilLastModifiedChanges(t *testing.T) {
+	var cache installedAppUpdateCache
+
+	t1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	stamps := []time.Time{t1}
+	updates := []codegen.ComposeAppUpdate{{StoreAppID: utils.Ptr("app")}}
+
+	if _, ok := cache.get(stamps); ok {
+		t.Fatal("expected miss on empty cache")
+	}
+
+	cache.set(stamps, updates)
+
+	if cached, ok := cache.get(stamps); !ok || len(cached) != 1 {
+		t.Fatalf("expected cache hit with 1 entry, got ok=%v cached=%v", ok, cached)
+	}
+
+	t2 := t1.Add(time.Hour)
+	if _, ok := cache.get([]time.Time{t2}); ok {
+		t.Fatal("expected miss once a store's LastModified changes")
+	}
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	"github.com/IceWhaleTech/CasaOS-AppManagement/codegen"
+)
+
+// AppStoreManagement manages the set of registered app stores.
+type AppStoreManagement interface {
+	AppStoreList() []codegen.AppStoreMetadata
+
+	// RegisterAppStore registers the app store at url and runs ValidateCatalog
+	// against it before returning, so the validation summary is available
+	// synchronously rather than only discoverable later through the logs.
+	RegisterAppStore(ctx context.Context, url string) (codegen.AppStoreMetadata, error)
+	UnregisterAppStore(id uint) error
+	CategoryMap() (map[string]codegen.CategoryInfo, error)
+
+	// TagMap aggregates, across every registered store, each tag in use and
+	// how many apps carry it.
+	TagMap() (map[string]codegen.Tag, error)
+
+	// Violations lists the per-app problems found by the last validation
+	// pass over the given store's catalog.
+	Violations(id uint) ([]string, error)
+
+	// Status reports the given store's background-sync health.
+	Status(id uint) (codegen.AppStoreStatus, error)
+
+	// Sync re-fetches the given store - over HTTP using ETag/
+	// If-Modified-Since for HTTP-backed stores, or `git fetch` for
+	// git-backed ones - revalidates its catalog, and records the result for
+	// Status to report. It runs until the fetch completes, so callers that
+	// don't want to block the caller's own request should run it in a
+	// goroutine (see route/v2/appstore.go's AppStoreSync handler).
+	Sync(ctx context.Context, id uint) error
+}
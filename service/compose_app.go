@@ -0,0 +1,87 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/IceWhaleTech/CasaOS-AppManagement/codegen"
+)
+
+// ComposeApp is the in-memory representation of a docker-compose application,
+// optionally enriched with the CasaOS-specific metadata carried in its
+// `x-casaos` compose extension. It shares its layout with codegen.ComposeApp
+// so it can be cast directly when returning the raw compose document to API
+// clients (see route/v2/appstore.go's ComposeApp handler).
+type ComposeApp codegen.ComposeApp
+
+// StoreInfo returns the app's CasaOS store metadata. withCategory controls
+// whether the (potentially expensive to resolve) category is populated -
+// callers that only need identity fields such as StoreAppID pass false.
+func (c *ComposeApp) StoreInfo(withCategory bool) (*codegen.ComposeAppStoreInfo, error) {
+	if c.XCasaOS == nil {
+		return nil, fmt.Errorf("compose app %s has no x-casaos store info", c.Name)
+	}
+
+	ext := c.XCasaOS
+
+	storeInfo := &codegen.ComposeAppStoreInfo{
+		StoreAppID: &ext.StoreAppID,
+		Version:    &ext.Version,
+	}
+
+	if withCategory {
+		storeInfo.Category = ext.Category
+	}
+
+	if ext.Tags != nil {
+		storeInfo.Tags = &ext.Tags
+	}
+
+	storeInfo.RecommendOrder = &ext.RecommendOrder
+
+	return storeInfo, nil
+}
+
+// AuthorType reports whether the app is an official, CasaOS-maintained, or
+// community app, as declared in its `x-casaos` extension.
+func (c *ComposeApp) AuthorType() codegen.StoreAppAuthorType {
+	if c.XCasaOS == nil || c.XCasaOS.AuthorType == "" {
+		return codegen.Community
+	}
+
+	return c.XCasaOS.AuthorType
+}
+
+// Versions returns the app's version history, oldest first, as declared in
+// its `x-casaos` extension's `versions` list.
+func (c *ComposeApp) Versions() ([]string, error) {
+	if c.XCasaOS == nil {
+		return nil, fmt.Errorf("compose app %s has no x-casaos store info", c.Name)
+	}
+
+	if len(c.XCasaOS.Versions) > 0 {
+		return c.XCasaOS.Versions, nil
+	}
+
+	if c.XCasaOS.Version == "" {
+		return nil, fmt.Errorf("compose app %s declares no versions", c.Name)
+	}
+
+	return []string{c.XCasaOS.Version}, nil
+}
+
+// LatestVersion returns the newest entry in Versions, ordered with compareVersions.
+func (c *ComposeApp) LatestVersion() (string, error) {
+	versions, err := c.Versions()
+	if err != nil {
+		return "", err
+	}
+
+	latest := versions[0]
+	for _, version := range versions[1:] {
+		if compareVersions(version, latest) > 0 {
+			latest = version
+		}
+	}
+
+	return latest, nil
+}
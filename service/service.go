@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotAppStore is returned by RegisterAppStore when the given URL does not
+// point to a valid app store.
+var ErrNotAppStore = errors.New("url does not point to a valid app store")
+
+// MyService is the process-wide service locator used by the v2 API handlers.
+var MyService Service
+
+// Service aggregates the sub-services the v2 API depends on.
+type Service interface {
+	AppStoreManagement() AppStoreManagement
+	V2AppStore() V2AppStore
+	Compose() Compose
+}
+
+// Compose manages docker-compose apps that are currently installed.
+type Compose interface {
+	List(ctx context.Context) (map[string]*ComposeApp, error)
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultSyncInterval is how often RunPeriodicSync re-fetches every
+// registered app store when the caller doesn't need a different cadence.
+const DefaultSyncInterval = 30 * time.Minute
+
+// StartPeriodicSync launches RunPeriodicSync in the background and returns
+// immediately. Call it exactly once during process startup, after MyService
+// has been initialized, alongside the manual POST /app-stores/{id}/sync
+// trigger the v2 handler exposes for on-demand refreshes. The goroutine it
+// starts runs until ctx is cancelled.
+func StartPeriodicSync(ctx context.Context, management AppStoreManagement) {
+	go RunPeriodicSync(ctx, management, DefaultSyncInterval)
+}
+
+// RunPeriodicSync re-fetches every registered app store on a fixed interval
+// until ctx is cancelled. Most callers want StartPeriodicSync instead, which
+// runs this in the background for them.
+func RunPeriodicSync(ctx context.Context, management AppStoreManagement, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultSyncInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			syncAll(ctx, management)
+		}
+	}
+}
+
+func syncAll(ctx context.Context, management AppStoreManagement) {
+	for id := range management.AppStoreList() {
+		// Errors are recorded on the store's status (see AppStoreManagement.Status)
+		// rather than surfaced here - one store failing to sync shouldn't stop
+		// the rest of the round.
+		_ = management.Sync(ctx, uint(id))
+	}
+}
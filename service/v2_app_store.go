@@ -0,0 +1,267 @@
+package service
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IceWhaleTech/CasaOS-AppManagement/codegen"
+)
+
+// CatalogSort selects the ordering Catalog applies to its result, on top of
+// whatever page/filter was requested.
+type CatalogSort string
+
+const (
+	// CatalogSortDefault leaves the catalog sorted by name, same as
+	// CatalogSortName - it's what Catalog falls back to when the caller
+	// doesn't care.
+	CatalogSortDefault CatalogSort = ""
+
+	// CatalogSortName orders alphabetically by app name.
+	CatalogSortName CatalogSort = "name"
+
+	// CatalogSortRecent orders by AddedAt, newest first.
+	CatalogSortRecent CatalogSort = "recent"
+
+	// CatalogSortRecommended orders by recommendOrder DESC, name ASC, letting
+	// store authors express a "featured order" instead of a binary recommend
+	// flag.
+	CatalogSortRecommended CatalogSort = "recommended"
+)
+
+// CatalogFilter narrows and orders Catalog's result. It's built from
+// codegen.ComposeAppStoreInfoListParams by the v2 handler and pushed down
+// here so that matching and pagination happen against the store's indexed
+// data instead of a client-side slice of the whole map - every field the
+// handler exposes as a filter belongs here, not applied after the page has
+// already been sliced.
+type CatalogFilter struct {
+	// Name, when non-empty, keeps only apps whose name contains it
+	// (case-insensitive).
+	Name string
+
+	// Tags, when non-empty, keeps only apps carrying at least one of these
+	// tags, or all of them when TagsMatchAll is set.
+	Tags         []string
+	TagsMatchAll bool
+
+	// Category, when non-empty, keeps only apps in that category
+	// (case-insensitive).
+	Category string
+
+	// AuthorType, when non-empty, keeps only apps whose AuthorType matches.
+	AuthorType codegen.StoreAppAuthorType
+
+	// IncludeTags/ExcludeTags apply alongside Tags/TagsMatchAll: an app must
+	// carry every tag in IncludeTags and none of the tags in ExcludeTags.
+	IncludeTags []string
+	ExcludeTags []string
+
+	Sort CatalogSort
+
+	// Page is 1-indexed. PageSize of 0 disables pagination.
+	Page     int
+	PageSize int
+}
+
+// V2AppStore exposes the app-store catalog backing the v2 API.
+type V2AppStore interface {
+	// Catalog returns the apps matching filter, the total number of matches
+	// before pagination was applied, and an error.
+	Catalog(filter CatalogFilter) (map[string]*ComposeApp, int, error)
+	ComposeApp(id string) (*ComposeApp, error)
+}
+
+// FilterCatalog applies a CatalogFilter to a store's catalog - keyed by
+// StoreAppID, same as the public catalog map - and returns the requested
+// page along with the total match count before pagination.
+func FilterCatalog(catalog map[string]*ComposeApp, filter CatalogFilter) (map[string]*ComposeApp, int, error) {
+	matches := make([]string, 0, len(catalog))
+
+	for storeAppID, composeApp := range catalog {
+		if !matchesName(composeApp, filter.Name) {
+			continue
+		}
+
+		if !matchesTags(composeApp, filter.Tags, filter.TagsMatchAll) {
+			continue
+		}
+
+		if !matchesCategory(composeApp, filter.Category) {
+			continue
+		}
+
+		if !matchesAuthorType(composeApp, filter.AuthorType) {
+			continue
+		}
+
+		if !matchesIncludeExcludeTags(composeApp, filter.IncludeTags, filter.ExcludeTags) {
+			continue
+		}
+
+		matches = append(matches, storeAppID)
+	}
+
+	sortMatches(catalog, matches, filter.Sort)
+
+	total := len(matches)
+
+	if filter.PageSize > 0 {
+		page := filter.Page
+		if page < 1 {
+			page = 1
+		}
+
+		start := (page - 1) * filter.PageSize
+		if start > len(matches) {
+			start = len(matches)
+		}
+
+		end := start + filter.PageSize
+		if end > len(matches) {
+			end = len(matches)
+		}
+
+		matches = matches[start:end]
+	}
+
+	page := make(map[string]*ComposeApp, len(matches))
+	for _, storeAppID := range matches {
+		page[storeAppID] = catalog[storeAppID]
+	}
+
+	return page, total, nil
+}
+
+func matchesName(composeApp *ComposeApp, name string) bool {
+	if name == "" {
+		return true
+	}
+
+	return strings.Contains(strings.ToLower(composeApp.Name), strings.ToLower(name))
+}
+
+func matchesTags(composeApp *ComposeApp, tags []string, matchAll bool) bool {
+	if len(tags) == 0 {
+		return true
+	}
+
+	storeInfo, err := composeApp.StoreInfo(true)
+	if err != nil || storeInfo == nil || storeInfo.Tags == nil {
+		return false
+	}
+
+	appTags := make(map[string]struct{}, len(*storeInfo.Tags))
+	for _, tag := range *storeInfo.Tags {
+		appTags[strings.ToLower(tag)] = struct{}{}
+	}
+
+	for _, tag := range tags {
+		_, ok := appTags[strings.ToLower(tag)]
+		if ok && !matchAll {
+			return true
+		}
+		if !ok && matchAll {
+			return false
+		}
+	}
+
+	return matchAll
+}
+
+func matchesCategory(composeApp *ComposeApp, category string) bool {
+	if category == "" {
+		return true
+	}
+
+	storeInfo, err := composeApp.StoreInfo(true)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(storeInfo.Category, category)
+}
+
+func matchesAuthorType(composeApp *ComposeApp, authorType codegen.StoreAppAuthorType) bool {
+	if authorType == "" {
+		return true
+	}
+
+	return composeApp.AuthorType() == authorType
+}
+
+// matchesIncludeExcludeTags requires composeApp to carry every tag in
+// include (when non-empty) and none of the tags in exclude.
+func matchesIncludeExcludeTags(composeApp *ComposeApp, include []string, exclude []string) bool {
+	if len(include) == 0 && len(exclude) == 0 {
+		return true
+	}
+
+	storeInfo, err := composeApp.StoreInfo(true)
+	if err != nil || storeInfo == nil || storeInfo.Tags == nil {
+		return len(include) == 0
+	}
+
+	appTags := make(map[string]struct{}, len(*storeInfo.Tags))
+	for _, tag := range *storeInfo.Tags {
+		appTags[strings.ToLower(tag)] = struct{}{}
+	}
+
+	for _, tag := range exclude {
+		if _, ok := appTags[strings.ToLower(tag)]; ok {
+			return false
+		}
+	}
+
+	for _, tag := range include {
+		if _, ok := appTags[strings.ToLower(tag)]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortMatches(catalog map[string]*ComposeApp, storeAppIDs []string, by CatalogSort) {
+	switch by {
+	case CatalogSortRecommended:
+		sort.SliceStable(storeAppIDs, func(i, j int) bool {
+			left, right := catalog[storeAppIDs[i]], catalog[storeAppIDs[j]]
+			leftOrder, rightOrder := recommendOrder(left), recommendOrder(right)
+			if leftOrder != rightOrder {
+				return leftOrder > rightOrder
+			}
+			return left.Name < right.Name
+		})
+	case CatalogSortRecent:
+		sort.SliceStable(storeAppIDs, func(i, j int) bool {
+			left, right := catalog[storeAppIDs[i]], catalog[storeAppIDs[j]]
+			leftAdded, rightAdded := addedAt(left), addedAt(right)
+			if !leftAdded.Equal(rightAdded) {
+				return leftAdded.After(rightAdded)
+			}
+			return left.Name < right.Name
+		})
+	default:
+		sort.SliceStable(storeAppIDs, func(i, j int) bool {
+			return catalog[storeAppIDs[i]].Name < catalog[storeAppIDs[j]].Name
+		})
+	}
+}
+
+func addedAt(composeApp *ComposeApp) time.Time {
+	if composeApp.AddedAt == nil {
+		return time.Time{}
+	}
+
+	return *composeApp.AddedAt
+}
+
+func recommendOrder(composeApp *ComposeApp) int {
+	if composeApp.XCasaOS == nil {
+		return 0
+	}
+
+	return composeApp.XCasaOS.RecommendOrder
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/IceWhaleTech/CasaOS-AppManagement/codegen"
+)
+
+func composeAppFixture(name, category string, tags []string, authorType codegen.StoreAppAuthorType, recommendOrder int, addedAt time.Time) *ComposeApp {
+	return &ComposeApp{
+		Name: name,
+		XCasaOS: &codegen.XCasaOSExtension{
+			Category:       category,
+			Tags:           tags,
+			AuthorType:     authorType,
+			RecommendOrder: recommendOrder,
+		},
+		AddedAt: &addedAt,
+	}
+}
+
+func testCatalog() map[string]*ComposeApp {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	return map[string]*ComposeApp{
+		"alpha":   composeAppFixture("Alpha", "media", []string{"video"}, codegen.Official, 1, base),
+		"bravo":   composeAppFixture("Bravo", "media", []string{"audio"}, codegen.Community, 2, base.Add(time.Hour)),
+		"charlie": composeAppFixture("Charlie", "utilities", []string{"video", "audio"}, codegen.ByCasaos, 0, base.Add(2*time.Hour)),
+	}
+}
+
+func TestFilterCatalogPagination(t *testing.T) {
+	catalog := testCatalog()
+
+	page, total, err := FilterCatalog(catalog, CatalogFilter{Page: 1, PageSize: 2})
+	if err != nil {
+		t.Fatalf("FilterCatalog: %v", err)
+	}
+
+	if total != 3 {
+		t.Fatalf("total = %d, want 3", total)
+	}
+
+	if len(page) != 2 {
+		t.Fatalf("page 1 size = %d, want 2", len(page))
+	}
+
+	page2, total2, err := FilterCatalog(catalog, CatalogFilter{Page: 2, PageSize: 2})
+	if err != nil {
+		t.Fatalf("FilterCatalog: %v", err)
+	}
+
+	if total2 != 3 {
+		t.Fatalf("total = %d, want 3", total2)
+	}
+
+	if len(page2) != 1 {
+		t.Fatalf("page 2 size = %d, want 1", len(page2))
+	}
+}
+
+func TestFilterCatalogFiltersBeforePagination(t *testing.T) {
+	catalog := testCatalog()
+
+	// Only "charlie" is in the "utilities" category. Total must reflect that
+	// match count, not the size of the unfiltered catalog, and "charlie" must
+	// come back even though it would land on a later page by name/tag
+	// filtering alone.
+	page, total, err := FilterCatalog(catalog, CatalogFilter{Category: "utilities", Page: 1, PageSize: 1})
+	if err != nil {
+		t.Fatalf("FilterCatalog: %v", err)
+	}
+
+	if total != 1 {
+		t.Fatalf("total = %d, want 1", total)
+	}
+
+	if _, ok := page["charlie"]; !ok {
+		t.Fatalf("expected charlie in page, got %v", page)
+	}
+}
+
+func TestFilterCatalogAuthorType(t *testing.T) {
+	catalog := testCatalog()
+
+	page, total, err := FilterCatalog(catalog, CatalogFilter{AuthorType: codegen.Official})
+	if err != nil {
+		t.Fatalf("FilterCatalog: %v", err)
+	}
+
+	if total != 1 {
+		t.Fatalf("total = %d, want 1", total)
+	}
+
+	if _, ok := page["alpha"]; !ok {
+		t.Fatalf("expected alpha in page, got %v", page)
+	}
+}
+
+func TestMatchesTags(t *testing.T) {
+	catalog := testCatalog()
+	charlie := catalog["charlie"]
+
+	if !matchesTags(charlie, []string{"video"}, false) {
+		t.Fatal("expected OR match on a shared tag")
+	}
+
+	if matchesTags(charlie, []string{"video", "missing"}, true) {
+		t.Fatal("expected AND match to fail when a tag is missing")
+	}
+
+	if !matchesTags(charlie, []string{"video", "audio"}, true) {
+		t.Fatal("expected AND match to succeed when every tag is present")
+	}
+}
+
+func TestFilterCatalogIncludeExcludeTags(t *testing.T) {
+	catalog := testCatalog()
+
+	page, total, err := FilterCatalog(catalog, CatalogFilter{IncludeTags: []string{"video"}, ExcludeTags: []string{"audio"}})
+	if err != nil {
+		t.Fatalf("FilterCatalog: %v", err)
+	}
+
+	// alpha has video only; bravo has audio only; charlie has both video and
+	// audio, so ExcludeTags should drop it even though IncludeTags matches.
+	if total != 1 {
+		t.Fatalf("total = %d, want 1", total)
+	}
+
+	if _, ok := page["alpha"]; !ok {
+		t.Fatalf("expected alpha in page, got %v", page)
+	}
+}
+
+func TestSortMatchesAppliesBeforePagination(t *testing.T) {
+	catalog := testCatalog()
+
+	cases := []struct {
+		name string
+		sort CatalogSort
+		want string
+	}{
+		{"default falls back to name asc", CatalogSortDefault, "alpha"},
+		{"name asc", CatalogSortName, "alpha"},
+		{"recommended: highest recommendOrder first", CatalogSortRecommended, "bravo"},
+		{"recent: newest AddedAt first", CatalogSortRecent, "charlie"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			page, total, err := FilterCatalog(catalog, CatalogFilter{Sort: tc.sort, Page: 1, PageSize: 1})
+			if err != nil {
+				t.Fatalf("FilterCatalog: %v", err)
+			}
+
+			if total != 3 {
+				t.Fatalf("total = %d, want 3", total)
+			}
+
+			if _, ok := page[tc.want]; !ok {
+				t.Fatalf("page 1 = %v, want only %q", page, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package service
+
+import "fmt"
+
+// ValidateCatalog walks every compose app in a store's catalog and checks
+// the store-info fields store authors are required to fill in, that
+// referenced StoreAppIDs are unique, and reports one violation string per
+// problem found. It's run by RegisterAppStore on every (re)registration and
+// again on every refresh, so that a broken store never registers silently.
+func ValidateCatalog(catalog map[string]*ComposeApp) (valid bool, violations []string) {
+	seenStoreAppIDs := make(map[string]string, len(catalog))
+
+	for name, composeApp := range catalog {
+		violations = append(violations, validateComposeApp(name, composeApp)...)
+
+		storeInfo, err := composeApp.StoreInfo(true)
+		if err != nil || storeInfo == nil || storeInfo.StoreAppID == nil || *storeInfo.StoreAppID == "" {
+			continue
+		}
+
+		storeAppID := *storeInfo.StoreAppID
+		if other, ok := seenStoreAppIDs[storeAppID]; ok {
+			violations = append(violations, fmt.Sprintf("duplicate StoreAppID %q used by both %q and %q", storeAppID, other, name))
+			continue
+		}
+
+		seenStoreAppIDs[storeAppID] = name
+	}
+
+	return len(violations) == 0, violations
+}
+
+func validateComposeApp(name string, composeApp *ComposeApp) []string {
+	var violations []string
+
+	if composeApp.XCasaOS == nil {
+		return []string{fmt.Sprintf("%s: missing x-casaos store info", name)}
+	}
+
+	ext := composeApp.XCasaOS
+
+	if ext.Title == "" {
+		violations = append(violations, fmt.Sprintf("%s: missing title", name))
+	}
+
+	if ext.Icon == "" {
+		violations = append(violations, fmt.Sprintf("%s: missing icon", name))
+	}
+
+	if ext.StoreAppID == "" {
+		violations = append(violations, fmt.Sprintf("%s: missing store_app_id", name))
+	}
+
+	if ext.Category == "" {
+		violations = append(violations, fmt.Sprintf("%s: missing category", name))
+	}
+
+	if ext.AuthorType == "" {
+		violations = append(violations, fmt.Sprintf("%s: missing author_type", name))
+	}
+
+	if ext.MainApp == "" {
+		violations = append(violations, fmt.Sprintf("%s: missing main app", name))
+	} else if _, ok := composeApp.Services[ext.MainApp]; !ok {
+		violations = append(violations, fmt.Sprintf("%s: main app %q does not reference a service in this compose file", name, ext.MainApp))
+	}
+
+	for serviceName, composeService := range composeApp.Services {
+		if composeService.Image == "" {
+			violations = append(violations, fmt.Sprintf("%s: service %q does not resolve to an image", name, serviceName))
+		}
+	}
+
+	return violations
+}
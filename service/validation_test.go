@@ -0,0 +1,89 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IceWhaleTech/CasaOS-AppManagement/codegen"
+)
+
+func TestValidateCatalogValid(t *testing.T) {
+	catalog := map[string]*ComposeApp{
+		"app": {
+			Name:     "App",
+			Services: map[string]codegen.ComposeService{"app": {Image: "app:latest"}},
+			XCasaOS: &codegen.XCasaOSExtension{
+				Title:      "App",
+				Icon:       "icon.png",
+				MainApp:    "app",
+				StoreAppID: "app",
+				Category:   "utilities",
+				AuthorType: codegen.Community,
+			},
+		},
+	}
+
+	valid, violations := ValidateCatalog(catalog)
+	if !valid {
+		t.Fatalf("expected valid, got violations: %v", violations)
+	}
+}
+
+func TestValidateCatalogMissingFields(t *testing.T) {
+	catalog := map[string]*ComposeApp{
+		"app": {
+			Name:     "App",
+			Services: map[string]codegen.ComposeService{"app": {}},
+			XCasaOS: &codegen.XCasaOSExtension{
+				MainApp: "app",
+			},
+		},
+	}
+
+	valid, violations := ValidateCatalog(catalog)
+	if valid {
+		t.Fatal("expected invalid")
+	}
+
+	if len(violations) == 0 {
+		t.Fatal("expected violations for missing title/icon/store_app_id/category/author_type/image")
+	}
+}
+
+func TestValidateCatalogDuplicateStoreAppID(t *testing.T) {
+	app := func(name string) *ComposeApp {
+		return &ComposeApp{
+			Name:     name,
+			Services: map[string]codegen.ComposeService{"svc": {Image: "img"}},
+			XCasaOS: &codegen.XCasaOSExtension{
+				Title:      name,
+				Icon:       "icon.png",
+				MainApp:    "svc",
+				StoreAppID: "shared-id",
+				Category:   "utilities",
+				AuthorType: codegen.Community,
+			},
+		}
+	}
+
+	catalog := map[string]*ComposeApp{
+		"first":  app("First"),
+		"second": app("Second"),
+	}
+
+	valid, violations := ValidateCatalog(catalog)
+	if valid {
+		t.Fatalf("expected invalid due to duplicate StoreAppID, got none: %v", violations)
+	}
+
+	found := false
+	for _, v := range violations {
+		if strings.Contains(v, "duplicate StoreAppID") {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Fatalf("expected a duplicate StoreAppID violation, got %v", violations)
+	}
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CompareVersions compares two dot-separated version strings numerically,
+// segment by segment, so "1.0" and "1.0.0" compare equal. Returns -1, 0 or 1.
+func CompareVersions(a, b string) int {
+	return compareVersions(a, b)
+}
+
+// IsCrossMajorVersionUpdate reports whether upgrading from current to latest
+// crosses a major version boundary, e.g. "1.4.2" -> "2.0.0". Versions whose
+// first segment can't be parsed as a number are treated as non-major.
+func IsCrossMajorVersionUpdate(current, latest string) bool {
+	currentMajor, err := strconv.Atoi(strings.Split(strings.TrimPrefix(current, "v"), ".")[0])
+	if err != nil {
+		return false
+	}
+
+	latestMajor, err := strconv.Atoi(strings.Split(strings.TrimPrefix(latest, "v"), ".")[0])
+	if err != nil {
+		return false
+	}
+
+	return currentMajor != latestMajor
+}
+
+// compareVersions compares two dot-separated version strings numerically,
+// segment by segment (so "1.0" and "1.0.0" compare equal, unlike a naive
+// string comparison). A missing trailing segment is treated as 0. Malformed
+// (non-numeric) segments compare as 0, so such versions sort together rather
+// than erroring. Returns -1, 0 or 1, mirroring strings.Compare.
+func compareVersions(a, b string) int {
+	aSegments := versionSegments(a)
+	bSegments := versionSegments(b)
+
+	for i := 0; i < len(aSegments) || i < len(bSegments); i++ {
+		var left, right int
+		if i < len(aSegments) {
+			left = aSegments[i]
+		}
+		if i < len(bSegments) {
+			right = bSegments[i]
+		}
+
+		if left != right {
+			if left < right {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func versionSegments(version string) []int {
+	version = strings.TrimPrefix(version, "v")
+	parts := strings.Split(version, ".")
+
+	segments := make([]int, len(parts))
+	for i, part := range parts {
+		segments[i], _ = strconv.Atoi(part)
+	}
+
+	return segments
+}
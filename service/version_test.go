@@ -0,0 +1,39 @@
+package service
+
+import "testing"
+
+func TestCompareVersions(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0", "1.0.0", 0},
+		{"1.0.0", "1.0", 0},
+		{"1.2.0", "1.10.0", -1},
+		{"2.0.0", "1.9.9", 1},
+		{"v1.0.0", "1.0.0", 0},
+	}
+
+	for _, tc := range cases {
+		if got := CompareVersions(tc.a, tc.b); got != tc.want {
+			t.Errorf("CompareVersions(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestIsCrossMajorVersionUpdate(t *testing.T) {
+	cases := []struct {
+		current, latest string
+		want            bool
+	}{
+		{"1.0", "1.0.0", false},
+		{"1.2.3", "1.9.0", false},
+		{"1.9.0", "2.0.0", true},
+	}
+
+	for _, tc := range cases {
+		if got := IsCrossMajorVersionUpdate(tc.current, tc.latest); got != tc.want {
+			t.Errorf("IsCrossMajorVersionUpdate(%q, %q) = %v, want %v", tc.current, tc.latest, got, tc.want)
+		}
+	}
+}